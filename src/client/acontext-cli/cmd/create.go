@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -14,7 +15,14 @@ import (
 )
 
 var (
-	templatePath string // Custom template path, e.g., "python/custom-template"
+	templatePath string   // Custom template path, e.g., "python/custom-template"
+	setValues    []string // Repeatable --set key=value overrides for manifest variables
+	valuesFile   string   // --values path to a YAML file of manifest variable answers
+
+	ciLanguage string // --language, e.g. "python"
+	ciTemplate string // --template, e.g. "python.openai" (short form of language.template)
+	assumeYes  bool   // --yes initializes git without prompting; it does not skip any other prompt
+	noGitFlag  bool   // --no-git skips git initialization without prompting
 )
 
 var CreateCmd = &cobra.Command{
@@ -41,23 +49,37 @@ Example:
 
 func init() {
 	CreateCmd.Flags().StringVarP(&templatePath, "template-path", "t", "", "Custom template folder path from Acontext-Examples repository (e.g., python/custom-template)")
+	CreateCmd.Flags().StringArrayVar(&setValues, "set", nil, "Set a template manifest variable (repeatable, e.g. --set openai_model=gpt-4o)")
+	CreateCmd.Flags().StringVar(&valuesFile, "values", "", "Path to a YAML file of template manifest variable answers")
+	CreateCmd.Flags().StringVar(&ciLanguage, "language", "", "Programming language, for non-interactive use (skips the language prompt)")
+	CreateCmd.Flags().StringVar(&ciTemplate, "template", "", "Template in 'language.template' form, for non-interactive use (skips language and template prompts)")
+	CreateCmd.Flags().BoolVarP(&assumeYes, "yes", "y", false, "Initialize Git without prompting (other prompts, such as project name, template variables and post-create commands, are still shown on a terminal)")
+	CreateCmd.Flags().BoolVar(&noGitFlag, "no-git", false, "Skip git initialization without prompting")
 }
 
 func runCreate(cmd *cobra.Command, args []string) error {
+	if err := checkNonInteractiveFlags(); err != nil {
+		return err
+	}
+
 	// 1. Get project name
 	var projectName string
 	if len(args) > 0 {
 		projectName = args[0]
 	} else {
 		defaultName := "my-acontext-app"
-		var err error
-		projectName, err = tui.RunInput("Project name:", "Enter a name for your project", defaultName)
-		if err != nil {
-			return fmt.Errorf("failed to get project name: %w", err)
-		}
-		// If user just pressed Enter, use default value
-		if projectName == "" {
+		if !tui.IsTTY() {
 			projectName = defaultName
+		} else {
+			var err error
+			projectName, err = tui.RunInput("Project name:", "Enter a name for your project", defaultName)
+			if err != nil {
+				return fmt.Errorf("failed to get project name: %w", err)
+			}
+			// If user just pressed Enter, use default value
+			if projectName == "" {
+				projectName = defaultName
+			}
 		}
 	}
 
@@ -92,21 +114,12 @@ func runCreate(cmd *cobra.Command, args []string) error {
 			Description: fmt.Sprintf("Custom template from %s", templatePath),
 		}
 	} else {
-		// 3. Select language
-		language, err := promptLanguage()
-		if err != nil {
-			return err
-		}
-		fmt.Printf("%s Selected language: %s\n", tui.SuccessStyle.Render(tui.IconSuccess), tui.SelectedStyle.Render(language))
-		fmt.Println()
-
-		// 4. Load config and select template
-		templateKey, preset, err := promptTemplate(language)
+		// 3/4. Resolve language + template, either from --template/--language
+		// or interactively.
+		templateKey, err := resolveTemplateKey()
 		if err != nil {
 			return err
 		}
-		fmt.Printf("%s Selected template: %s\n", tui.SuccessStyle.Render(tui.IconSuccess), tui.SelectedStyle.Render(preset.Name))
-		fmt.Println()
 
 		// 5. Get template config
 		// Parse template key (e.g., "python.openai")
@@ -142,22 +155,59 @@ func runCreate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to create project directory: %w", err)
 	}
 
-	// 7. Download template with project name variable
-	vars := map[string]string{
-		"project_name": projectName,
-	}
-
+	// 7. Download the template's raw files. Variable substitution (project
+	// name plus any manifest variables) happens afterward in a single pass
+	// — see 7b. Rendering project_name here, before the manifest variables
+	// are known, would let text/template's default missing-key behavior
+	// ("<no value>") permanently stamp over any other variable placeholder
+	// in the same file, with nothing left for the later pass to fill in.
 	fmt.Printf("%s Downloading template...\n", tui.IconDownload)
-	if err := template.DownloadTemplateWithVars(templateConfig, projectDir, vars); err != nil {
+	if err := template.DownloadTemplate(templateConfig, projectDir); err != nil {
 		return fmt.Errorf("failed to download template: %w", err)
 	}
 	fmt.Println()
 
-	// 8. Ask whether to initialize Git
-	initGit, err := tui.RunConfirm("Would you like to initialize a Git repository?", true)
+	// 7b. Load the template's manifest (if any), collect every variable —
+	// project_name plus whatever the manifest declares — and render the
+	// whole project in one pass.
+	vars := map[string]string{
+		"project_name": projectName,
+	}
+
+	manifest, err := template.LoadManifest(projectDir)
 	if err != nil {
-		// User cancelled, treat as no
+		return fmt.Errorf("failed to load template manifest: %w", err)
+	}
+	if manifest != nil {
+		if err := template.ParseSetFlags(setValues, vars); err != nil {
+			return err
+		}
+		if valuesFile != "" {
+			if err := template.LoadValuesFile(valuesFile, vars); err != nil {
+				return err
+			}
+		}
+		if err := template.PromptVariables(manifest, vars); err != nil {
+			return fmt.Errorf("failed to collect template variables: %w", err)
+		}
+	}
+	if err := template.RenderVars(projectDir, vars); err != nil {
+		return fmt.Errorf("failed to render template variables: %w", err)
+	}
+
+	// 8. Decide whether to initialize Git
+	var initGit bool
+	switch {
+	case noGitFlag:
 		initGit = false
+	case assumeYes:
+		initGit = true
+	default:
+		initGit, err = tui.RunConfirm("Would you like to initialize a Git repository?", true)
+		if err != nil {
+			// User cancelled, treat as no
+			initGit = false
+		}
 	}
 
 	if initGit {
@@ -175,6 +225,13 @@ func runCreate(cmd *cobra.Command, args []string) error {
 		fmt.Println()
 	}
 
+	// 8b. Run any post-create hooks declared by the manifest
+	if manifest != nil {
+		if err := template.RunHooks(manifest, projectDir); err != nil {
+			fmt.Printf("%s Warning: post-create command failed: %v\n", tui.WarningStyle.Render(tui.IconWarning), err)
+		}
+	}
+
 	// 9. Display success message
 	fmt.Println()
 	fmt.Printf("%s Project created successfully!\n", tui.IconDone)
@@ -196,6 +253,59 @@ func runCreate(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// checkNonInteractiveFlags fails fast, before any prompt would otherwise
+// block on a missing TTY, if required flags weren't supplied for a
+// non-interactive (CI) invocation.
+func checkNonInteractiveFlags() error {
+	if tui.IsTTY() {
+		return nil
+	}
+
+	var missing []string
+	if templatePath == "" && ciTemplate == "" {
+		missing = append(missing, "--template (or --template-path)")
+	}
+	if !noGitFlag && !assumeYes {
+		missing = append(missing, "--yes or --no-git")
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("no TTY detected for interactive prompts; pass the following flag(s) for non-interactive use: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// resolveTemplateKey returns the "language.template" key to use, taking it
+// from --template or --language when set and otherwise falling back to the
+// interactive language/template prompts.
+func resolveTemplateKey() (string, error) {
+	if ciTemplate != "" {
+		fmt.Printf("%s Using template: %s\n", tui.SuccessStyle.Render(tui.IconSuccess), tui.SelectedStyle.Render(ciTemplate))
+		fmt.Println()
+		return ciTemplate, nil
+	}
+
+	language := ciLanguage
+	if language == "" {
+		var err error
+		language, err = promptLanguage()
+		if err != nil {
+			return "", err
+		}
+	}
+	fmt.Printf("%s Selected language: %s\n", tui.SuccessStyle.Render(tui.IconSuccess), tui.SelectedStyle.Render(language))
+	fmt.Println()
+
+	templateKey, preset, err := promptTemplate(language)
+	if err != nil {
+		return "", err
+	}
+	fmt.Printf("%s Selected template: %s\n", tui.SuccessStyle.Render(tui.IconSuccess), tui.SelectedStyle.Render(preset.Name))
+	fmt.Println()
+
+	return templateKey, nil
+}
+
 // validateProjectName validates the project name
 func validateProjectName(name string) error {
 	if name == "" {
@@ -258,8 +368,11 @@ func promptTemplate(language string) (string, *config.Preset, error) {
 	// Show spinner if we need to discover templates
 	if needsDiscovery {
 		var discoverErr error
-		_, spinnerErr := tui.RunSpinner("Discovering templates from repository", func() (string, error) {
-			presets, discoverErr = config.GetPresets(language)
+		_, spinnerErr := tui.RunSpinnerCtx(context.Background(), "Discovering templates from repository", func(ctx context.Context) (string, error) {
+			// ctx is passed into GetPresets itself, not just checked
+			// afterward, so Ctrl+C can abort the in-flight discovery
+			// request instead of waiting for it to finish regardless.
+			presets, discoverErr = config.GetPresets(ctx, language)
 			if discoverErr != nil {
 				return "", discoverErr
 			}
@@ -272,7 +385,7 @@ func promptTemplate(language string) (string, *config.Preset, error) {
 			return "", nil, fmt.Errorf("failed to get presets: %w", discoverErr)
 		}
 	} else {
-		presets, err = config.GetPresets(language)
+		presets, err = config.GetPresets(context.Background(), language)
 		if err != nil {
 			return "", nil, fmt.Errorf("failed to get presets: %w", err)
 		}
@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/memodb-io/Acontext/acontext-cli/internal/tui"
+	"github.com/memodb-io/Acontext/acontext-cli/internal/version"
+	"github.com/spf13/cobra"
+)
+
+var (
+	noUpdateCheck     bool
+	autoUpgrade       bool
+	updateCheckTTLStr string
+
+	// defaultUpdateCheckTTL is how often the background check refreshes the
+	// cache when neither --update-check-ttl nor ACONTEXT_UPDATE_CHECK_TTL is set.
+	defaultUpdateCheckTTL = 24 * time.Hour
+)
+
+// RootCmd is the base command for the acontext CLI
+var RootCmd = &cobra.Command{
+	Use:   "acontext",
+	Short: "Acontext CLI - scaffold and manage Acontext projects",
+	Long: `Acontext CLI helps you create, run, and maintain Acontext projects.
+
+Run 'acontext create' to scaffold a new project from a template, or
+'acontext upgrade' to update the CLI itself.`,
+	PersistentPreRunE:  rootPersistentPreRun,
+	PersistentPostRunE: rootPersistentPostRun,
+}
+
+func init() {
+	RootCmd.PersistentFlags().BoolVar(&noUpdateCheck, "no-update-check", false, "Disable the background update check for this invocation")
+	RootCmd.PersistentFlags().BoolVar(&autoUpgrade, "auto-upgrade", false, "Silently upgrade instead of printing an update notice when a new version is available")
+	RootCmd.PersistentFlags().StringVar(&updateCheckTTLStr, "update-check-ttl", "", "How often the background update check refreshes (default 24h; also settable via ACONTEXT_UPDATE_CHECK_TTL)")
+
+	RootCmd.AddCommand(CreateCmd)
+	RootCmd.AddCommand(UpgradeCmd)
+	RootCmd.AddCommand(UpdateCmd)
+}
+
+// updateCheckDisabled reports whether the background update check has been
+// disabled via flag or environment variable.
+func updateCheckDisabled() bool {
+	if noUpdateCheck {
+		return true
+	}
+	return os.Getenv("ACONTEXT_DISABLE_UPDATE_CHECK") == "1"
+}
+
+// rootPersistentPreRun reads the cached update-check result (if any) and,
+// before the requested subcommand runs, either prints a one-line notice or
+// silently upgrades when --auto-upgrade is set.
+func rootPersistentPreRun(cmd *cobra.Command, args []string) error {
+	if updateCheckDisabled() {
+		return nil
+	}
+
+	currentVersion := GetVersion(cmd)
+	cache, err := version.LoadCache()
+	if err != nil || cache == nil || cache.LatestVersion == "" {
+		return nil
+	}
+	if cache.LatestVersion == currentVersion {
+		return nil
+	}
+
+	if autoUpgrade {
+		return runSilentAutoUpgrade()
+	}
+
+	fmt.Printf("%s A new version %s is available — run 'acontext upgrade'\n",
+		tui.MutedStyle.Render(tui.IconPackage), tui.SuccessStyle.Render(cache.LatestVersion))
+	return nil
+}
+
+// rootPersistentPostRun refreshes the update cache once the subcommand has
+// finished, so the *next* invocation has fresh data. This blocks for up to
+// the background check's short deadline rather than detaching a goroutine:
+// the process exits as soon as Execute() returns, right after this runs, so
+// nothing would otherwise keep an async check alive long enough to finish.
+func rootPersistentPostRun(cmd *cobra.Command, args []string) error {
+	if updateCheckDisabled() {
+		return nil
+	}
+
+	currentVersion := GetVersion(cmd)
+	cache, err := version.LoadCache()
+	if err == nil && cache != nil && !version.CacheExpired(cache, updateCheckTTL()) {
+		return nil
+	}
+
+	version.RunBackgroundCheck(currentVersion)
+	return nil
+}
+
+// updateCheckTTL resolves how often the background check should refresh the
+// cache: --update-check-ttl, then ACONTEXT_UPDATE_CHECK_TTL, then
+// defaultUpdateCheckTTL. Invalid values fall back to the default.
+func updateCheckTTL() time.Duration {
+	raw := updateCheckTTLStr
+	if raw == "" {
+		raw = os.Getenv("ACONTEXT_UPDATE_CHECK_TTL")
+	}
+	if raw == "" {
+		return defaultUpdateCheckTTL
+	}
+	ttl, err := time.ParseDuration(raw)
+	if err != nil {
+		return defaultUpdateCheckTTL
+	}
+	return ttl
+}
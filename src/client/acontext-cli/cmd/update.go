@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/memodb-io/Acontext/acontext-cli/internal/sdkupdate"
+	"github.com/memodb-io/Acontext/acontext-cli/internal/tui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	updateApply bool
+	updateMajor bool
+	updateCheck bool
+)
+
+var UpdateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Check for newer Acontext SDK versions used by this project",
+	Long: `Scan the current project for Acontext SDK dependencies (Python, Node, or
+Go) and check whether newer versions are available upstream.
+
+By default this only prints a current -> latest table. Use --apply to
+rewrite the manifest in place, --major to allow major version bumps
+(minor/patch only by default), or --check for a CI-friendly mode that
+exits non-zero when an update is available without printing anything.
+`,
+	RunE: runUpdate,
+}
+
+func init() {
+	UpdateCmd.Flags().BoolVar(&updateApply, "apply", false, "Rewrite the manifest(s) in place with the latest versions")
+	UpdateCmd.Flags().BoolVar(&updateMajor, "major", false, "Allow major version bumps (default: minor/patch only)")
+	UpdateCmd.Flags().BoolVar(&updateCheck, "check", false, "Exit non-zero if an update is available; prints nothing")
+}
+
+func runUpdate(cmd *cobra.Command, args []string) error {
+	dir, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %w", err)
+	}
+
+	deps, err := sdkupdate.Detect(dir)
+	if err != nil {
+		return fmt.Errorf("failed to scan project for Acontext SDK dependencies: %w", err)
+	}
+	if len(deps) == 0 {
+		if !updateCheck {
+			fmt.Println("No Acontext SDK dependencies found in this project.")
+		}
+		return nil
+	}
+
+	lookup := func() error {
+		for _, dep := range deps {
+			latest, err := sdkupdate.LatestVersion(dep)
+			if err != nil {
+				return err
+			}
+			dep.Latest = latest
+		}
+		return nil
+	}
+
+	if updateCheck {
+		// --check is exit-code-only CI mode: no spinner, no progress output.
+		if err := lookup(); err != nil {
+			return fmt.Errorf("failed to check for updates: %w", err)
+		}
+	} else {
+		var lookupErr error
+		_, err = tui.RunSpinner(fmt.Sprintf("Checking %d dependency registries", len(deps)), func() (string, error) {
+			if lookupErr = lookup(); lookupErr != nil {
+				return "", lookupErr
+			}
+			return "Done", nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to check for updates: %w", lookupErr)
+		}
+	}
+
+	hasUpdate := false
+	for _, dep := range deps {
+		current, currErr := sdkupdate.ParseVersion(dep.Current)
+		latest, latestErr := sdkupdate.ParseVersion(dep.Latest)
+		if currErr != nil || latestErr != nil {
+			continue
+		}
+		if sdkupdate.AllowedBump(current, latest, updateMajor) {
+			hasUpdate = true
+		}
+	}
+
+	if updateCheck {
+		if hasUpdate {
+			// Keep cobra from printing the error and usage text.
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+			return fmt.Errorf("updates available")
+		}
+		return nil
+	}
+
+	printUpdateTable(deps)
+
+	if updateApply {
+		for _, dep := range deps {
+			current, currErr := sdkupdate.ParseVersion(dep.Current)
+			latest, latestErr := sdkupdate.ParseVersion(dep.Latest)
+			if currErr != nil || latestErr != nil || !sdkupdate.AllowedBump(current, latest, updateMajor) {
+				continue
+			}
+			if err := sdkupdate.Apply(dep); err != nil {
+				fmt.Printf("%s Failed to update %s: %v\n", tui.WarningStyle.Render(tui.IconWarning), dep.Name, err)
+				continue
+			}
+			fmt.Printf("%s Updated %s to %s in %s\n", tui.SuccessStyle.Render(tui.IconSuccess), dep.Name, dep.Latest, dep.ManifestPath)
+		}
+	} else if hasUpdate {
+		fmt.Println()
+		fmt.Println("Run 'acontext update --apply' to write these changes to the manifest(s).")
+	}
+
+	return nil
+}
+
+func printUpdateTable(deps []*sdkupdate.Dependency) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 2, 2, ' ', 0)
+	fmt.Fprintln(w, "ECOSYSTEM\tNAME\tCURRENT\tLATEST\tMANIFEST")
+	for _, dep := range deps {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", dep.Ecosystem, dep.Name, dep.Current, dep.Latest, dep.ManifestPath)
+	}
+	w.Flush()
+}
@@ -12,20 +12,24 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var (
+	useInstallScript bool
+	rollback         bool
+)
+
 var UpgradeCmd = &cobra.Command{
 	Use:   "upgrade",
 	Short: "Upgrade Acontext CLI to the latest version",
 	Long: `Upgrade Acontext CLI to the latest version.
 
-This command downloads and installs the latest version of Acontext CLI
-by executing the installation script from install.acontext.io.
+By default this command downloads the matching release binary for your
+platform directly from GitHub Releases, verifies its checksum (and
+signature, if this build pins a verification key), and atomically replaces
+the running binary — no sudo or shell pipe required.
 
-The upgrade process:
-  1. Checks for the latest available version
-  2. Downloads the installation script
-  3. Executes the script to upgrade the CLI
-
-Note: This command requires sudo privileges on most systems.
+Use --use-install-script to fall back to the legacy install.acontext.io
+shell script instead, or --rollback to restore the binary an upgrade just
+replaced.
 `,
 	RunE: runUpgrade,
 }
@@ -35,6 +39,11 @@ type VersionKey string
 
 const versionKey VersionKey = "version"
 
+func init() {
+	UpgradeCmd.Flags().BoolVar(&useInstallScript, "use-install-script", false, "Upgrade via the legacy install.acontext.io shell script instead of the native binary swap")
+	UpgradeCmd.Flags().BoolVar(&rollback, "rollback", false, "Restore the binary replaced by the previous upgrade")
+}
+
 // SetVersion sets the version in the command context
 func SetVersion(cmd *cobra.Command, v string) {
 	ctx := cmd.Context()
@@ -59,6 +68,10 @@ func GetVersion(cmd *cobra.Command) string {
 }
 
 func runUpgrade(cmd *cobra.Command, args []string) error {
+	if rollback {
+		return runRollback()
+	}
+
 	currentVersion := GetVersion(cmd)
 
 	var hasUpdate bool
@@ -110,10 +123,15 @@ func runUpgrade(cmd *cobra.Command, args []string) error {
 	fmt.Printf("%s Starting upgrade...\n", tui.IconRocket)
 	fmt.Println()
 
-	// Execute the installation script
-	installScriptURL := "https://install.acontext.io"
-	if err := executeInstallScript(installScriptURL); err != nil {
-		return fmt.Errorf("upgrade failed: %w", err)
+	if useInstallScript {
+		installScriptURL := "https://install.acontext.io"
+		if err := executeInstallScript(installScriptURL); err != nil {
+			return fmt.Errorf("upgrade failed: %w", err)
+		}
+	} else {
+		if err := runNativeUpgrade(latestVersion); err != nil {
+			return fmt.Errorf("upgrade failed: %w", err)
+		}
 	}
 
 	fmt.Println()
@@ -123,6 +141,53 @@ func runUpgrade(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// runNativeUpgrade downloads the matching release binary for this platform,
+// verifies it, and atomically swaps it in for the running executable.
+func runNativeUpgrade(latestVersion string) error {
+	tmpDir, err := version.NewStagingDir()
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	_, err = tui.RunSpinnerCtx(context.Background(), "Fetching release metadata", func(ctx context.Context) (string, error) {
+		release, err := version.PerformUpgrade(ctx, tmpDir)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Installed %s", release.TagName), nil
+	})
+
+	return err
+}
+
+// runSilentAutoUpgrade installs the latest release via the native upgrade path
+// with no prompts and no progress output, for use by --auto-upgrade. It
+// never falls back to --use-install-script, since that path requires a
+// foreground shell and user input. A failed background upgrade isn't
+// treated as fatal: it's swallowed so it can't block the command the user
+// actually ran.
+func runSilentAutoUpgrade() error {
+	tmpDir, err := version.NewStagingDir()
+	if err != nil {
+		return nil
+	}
+	defer os.RemoveAll(tmpDir)
+
+	_, _ = version.PerformUpgrade(context.Background(), tmpDir)
+	return nil
+}
+
+// runRollback restores the binary a previous native upgrade replaced.
+func runRollback() error {
+	fmt.Printf("%s Rolling back to the previous version...\n", tui.IconRocket)
+	if err := version.RollbackUpgrade(); err != nil {
+		return fmt.Errorf("rollback failed: %w", err)
+	}
+	fmt.Printf("%s Rollback complete!\n", tui.IconDone)
+	return nil
+}
+
 // getCurrentVersionFallback gets the current version by executing the version command
 // This is a fallback method when version is not available in context
 func getCurrentVersionFallback() string {
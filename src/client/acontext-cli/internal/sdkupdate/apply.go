@@ -0,0 +1,47 @@
+package sdkupdate
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Apply rewrites dep's manifest in place so it requires dep.Latest instead
+// of dep.Current, preserving any constraint operator/prefix already used.
+//
+// The replacement is scoped to dep.Match — the name-anchored snippet the
+// detector recorded Current in — rather than a free-text search for
+// dep.Current across the whole file, so a version string that's also a
+// substring of an unrelated dependency's version (e.g. "1.2.3" inside
+// "11.2.3") can't be rewritten by mistake.
+func Apply(dep *Dependency) error {
+	if dep.Match == "" {
+		return fmt.Errorf("no match recorded for %s; refusing to apply", dep.Name)
+	}
+
+	data, err := os.ReadFile(dep.ManifestPath)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", dep.ManifestPath, err)
+	}
+	content := string(data)
+
+	// Current may carry a constraint (e.g. "^1.2.3" from package.json or
+	// pyproject.toml); only its leading version number is swapped so the
+	// operator stays in place.
+	oldVersion := strings.TrimLeft(dep.Current, "^~>=< ")
+	if idx := strings.IndexAny(oldVersion, ",<> "); idx != -1 {
+		oldVersion = oldVersion[:idx]
+	}
+
+	newMatch := strings.Replace(dep.Match, oldVersion, dep.Latest, 1)
+	if newMatch == dep.Match {
+		return fmt.Errorf("could not find %s %s in %s to replace", dep.Name, dep.Current, dep.ManifestPath)
+	}
+
+	updated := strings.Replace(content, dep.Match, newMatch, 1)
+	if updated == content {
+		return fmt.Errorf("could not find %s entry in %s to replace", dep.Name, dep.ManifestPath)
+	}
+
+	return os.WriteFile(dep.ManifestPath, []byte(updated), 0644)
+}
@@ -0,0 +1,71 @@
+package sdkupdate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestApplyScopesReplacementToMatch(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "requirements.txt")
+	original := "numpy==11.2.3\nacontext==1.2.3\n"
+	if err := os.WriteFile(path, []byte(original), 0644); err != nil {
+		t.Fatalf("failed to write requirements.txt: %v", err)
+	}
+
+	dep := &Dependency{
+		Ecosystem:    EcosystemPython,
+		Name:         "acontext",
+		Current:      "1.2.3",
+		Latest:       "1.3.0",
+		ManifestPath: path,
+		Match:        "acontext==1.2.3",
+	}
+
+	if err := Apply(dep); err != nil {
+		t.Fatalf("Apply returned error: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read back requirements.txt: %v", err)
+	}
+
+	want := "numpy==11.2.3\nacontext==1.3.0\n"
+	if string(data) != want {
+		t.Errorf("requirements.txt = %q, want %q (numpy's version must be untouched)", string(data), want)
+	}
+}
+
+func TestApplyNoMatchRecorded(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "requirements.txt")
+	if err := os.WriteFile(path, []byte("acontext==1.2.3\n"), 0644); err != nil {
+		t.Fatalf("failed to write requirements.txt: %v", err)
+	}
+
+	dep := &Dependency{Name: "acontext", Current: "1.2.3", Latest: "1.3.0", ManifestPath: path}
+	if err := Apply(dep); err == nil {
+		t.Error("expected error for a dependency with no recorded Match, got nil")
+	}
+}
+
+func TestApplyMatchNotFound(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "requirements.txt")
+	if err := os.WriteFile(path, []byte("acontext==1.2.3\n"), 0644); err != nil {
+		t.Fatalf("failed to write requirements.txt: %v", err)
+	}
+
+	dep := &Dependency{
+		Name:         "acontext",
+		Current:      "1.2.3",
+		Latest:       "1.3.0",
+		ManifestPath: path,
+		Match:        "acontext==9.9.9", // no longer present in the file
+	}
+	if err := Apply(dep); err == nil {
+		t.Error("expected error when Match can't be found in the manifest, got nil")
+	}
+}
@@ -0,0 +1,214 @@
+package sdkupdate
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Ecosystem identifies which package registry a dependency is resolved
+// against.
+type Ecosystem string
+
+const (
+	EcosystemPython Ecosystem = "python"
+	EcosystemNode   Ecosystem = "node"
+	EcosystemGo     Ecosystem = "go"
+)
+
+// Dependency is one Acontext SDK dependency found in a project manifest.
+type Dependency struct {
+	Ecosystem    Ecosystem
+	Name         string
+	Current      string
+	Latest       string
+	ManifestPath string
+
+	// Match is the exact, name-scoped snippet of the manifest's raw text
+	// that pins Current (a whole requirements.txt/go.mod line, or a
+	// "name": "version" / name = "version" fragment). Apply rewrites only
+	// this snippet, so a version string that also happens to be a
+	// substring of an unrelated dependency's version is never touched.
+	Match string
+}
+
+// knownPackageNames are the package names across ecosystems this command
+// knows how to bump.
+var knownPackageNames = map[Ecosystem][]string{
+	EcosystemPython: {"acontext"},
+	EcosystemNode:   {"@memodb/acontext"},
+}
+
+// goModulePrefix matches the Go module path(s) published for Acontext.
+const goModulePrefix = "github.com/memodb-io/Acontext/"
+
+// Detect scans dir for known Acontext SDK dependencies across
+// requirements.txt, pyproject.toml, package.json, and go.mod.
+func Detect(dir string) ([]*Dependency, error) {
+	var deps []*Dependency
+
+	for _, detector := range []func(string) ([]*Dependency, error){
+		detectRequirementsTxt,
+		detectPyprojectToml,
+		detectPackageJSON,
+		detectGoMod,
+	} {
+		found, err := detector(dir)
+		if err != nil {
+			return nil, err
+		}
+		deps = append(deps, found...)
+	}
+
+	return deps, nil
+}
+
+var requirementLine = regexp.MustCompile(`^([A-Za-z0-9_.\-@/]+)\s*(==|>=|~=|\^)?\s*([0-9][0-9A-Za-z.\-]*)?`)
+
+func detectRequirementsTxt(dir string) ([]*Dependency, error) {
+	path := filepath.Join(dir, "requirements.txt")
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var deps []*Dependency
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		m := requirementLine.FindStringSubmatch(line)
+		if m == nil || !isKnown(EcosystemPython, m[1]) {
+			continue
+		}
+		deps = append(deps, &Dependency{
+			Ecosystem:    EcosystemPython,
+			Name:         m[1],
+			Current:      m[3],
+			ManifestPath: path,
+			Match:        line,
+		})
+	}
+	return deps, scanner.Err()
+}
+
+func detectPyprojectToml(dir string) ([]*Dependency, error) {
+	path := filepath.Join(dir, "pyproject.toml")
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var deps []*Dependency
+	for _, name := range knownPackageNames[EcosystemPython] {
+		re := regexp.MustCompile(`(?m)^\s*"?` + regexp.QuoteMeta(name) + `"?\s*=\s*"([^"]+)"`)
+		if m := re.FindSubmatch(data); m != nil {
+			deps = append(deps, &Dependency{
+				Ecosystem:    EcosystemPython,
+				Name:         name,
+				Current:      string(m[1]),
+				ManifestPath: path,
+				Match:        string(m[0]),
+			})
+		}
+	}
+	return deps, nil
+}
+
+func detectPackageJSON(dir string) ([]*Dependency, error) {
+	path := filepath.Join(dir, "package.json")
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest struct {
+		Dependencies    map[string]string `json:"dependencies"`
+		DevDependencies map[string]string `json:"devDependencies"`
+	}
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse package.json: %w", err)
+	}
+
+	var deps []*Dependency
+	for _, group := range []map[string]string{manifest.Dependencies, manifest.DevDependencies} {
+		for name, version := range group {
+			if !isKnown(EcosystemNode, name) {
+				continue
+			}
+			fieldRe := regexp.MustCompile(`"` + regexp.QuoteMeta(name) + `"\s*:\s*"` + regexp.QuoteMeta(version) + `"`)
+			match := fieldRe.Find(data)
+			if match == nil {
+				continue
+			}
+			deps = append(deps, &Dependency{
+				Ecosystem:    EcosystemNode,
+				Name:         name,
+				Current:      version,
+				ManifestPath: path,
+				Match:        string(match),
+			})
+		}
+	}
+	return deps, nil
+}
+
+var goRequireLine = regexp.MustCompile(`^\s*(\S+)\s+(v\S+)`)
+
+func detectGoMod(dir string) ([]*Dependency, error) {
+	path := filepath.Join(dir, "go.mod")
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var deps []*Dependency
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, goModulePrefix) {
+			continue
+		}
+		m := goRequireLine.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		deps = append(deps, &Dependency{
+			Ecosystem:    EcosystemGo,
+			Name:         m[1],
+			Current:      m[2],
+			ManifestPath: path,
+			Match:        line,
+		})
+	}
+	return deps, scanner.Err()
+}
+
+func isKnown(ecosystem Ecosystem, name string) bool {
+	for _, known := range knownPackageNames[ecosystem] {
+		if known == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+package sdkupdate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write %s: %v", name, err)
+	}
+	return path
+}
+
+func TestDetectRequirementsTxt(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "requirements.txt", "numpy==11.2.3\nacontext==1.2.3\n# a comment\nrequests\n")
+
+	deps, err := detectRequirementsTxt(dir)
+	if err != nil {
+		t.Fatalf("detectRequirementsTxt returned error: %v", err)
+	}
+	if len(deps) != 1 {
+		t.Fatalf("expected 1 dependency, got %d: %+v", len(deps), deps)
+	}
+	dep := deps[0]
+	if dep.Name != "acontext" || dep.Current != "1.2.3" {
+		t.Errorf("got Name=%q Current=%q, want Name=%q Current=%q", dep.Name, dep.Current, "acontext", "1.2.3")
+	}
+	if dep.Match != "acontext==1.2.3" {
+		t.Errorf("Match = %q, want %q", dep.Match, "acontext==1.2.3")
+	}
+}
+
+func TestDetectRequirementsTxtMissing(t *testing.T) {
+	dir := t.TempDir()
+	deps, err := detectRequirementsTxt(dir)
+	if err != nil {
+		t.Fatalf("detectRequirementsTxt returned error: %v", err)
+	}
+	if deps != nil {
+		t.Errorf("expected nil deps for missing file, got %+v", deps)
+	}
+}
+
+func TestDetectPyprojectToml(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "pyproject.toml", "[tool.poetry.dependencies]\nacontext = \"1.2.3\"\nother = \"9.9.9\"\n")
+
+	deps, err := detectPyprojectToml(dir)
+	if err != nil {
+		t.Fatalf("detectPyprojectToml returned error: %v", err)
+	}
+	if len(deps) != 1 || deps[0].Current != "1.2.3" {
+		t.Fatalf("unexpected deps: %+v", deps)
+	}
+}
+
+func TestDetectPackageJSON(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "package.json", `{
+  "dependencies": { "@memodb/acontext": "1.2.3", "left-pad": "11.2.3" }
+}`)
+
+	deps, err := detectPackageJSON(dir)
+	if err != nil {
+		t.Fatalf("detectPackageJSON returned error: %v", err)
+	}
+	if len(deps) != 1 {
+		t.Fatalf("expected 1 dependency, got %d: %+v", len(deps), deps)
+	}
+	if deps[0].Current != "1.2.3" {
+		t.Errorf("Current = %q, want %q", deps[0].Current, "1.2.3")
+	}
+}
+
+func TestDetectGoMod(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "go.mod", "module example.com/thing\n\nrequire (\n\tgithub.com/memodb-io/Acontext/acontext-go v1.2.3\n\tgithub.com/other/pkg v9.9.9\n)\n")
+
+	deps, err := detectGoMod(dir)
+	if err != nil {
+		t.Fatalf("detectGoMod returned error: %v", err)
+	}
+	if len(deps) != 1 {
+		t.Fatalf("expected 1 dependency, got %d: %+v", len(deps), deps)
+	}
+	if deps[0].Name != "github.com/memodb-io/Acontext/acontext-go" || deps[0].Current != "v1.2.3" {
+		t.Errorf("got %+v", deps[0])
+	}
+}
@@ -0,0 +1,98 @@
+package sdkupdate
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// LatestVersion queries the registry for dep's ecosystem and returns the
+// latest published version string.
+func LatestVersion(dep *Dependency) (string, error) {
+	switch dep.Ecosystem {
+	case EcosystemPython:
+		return latestPyPI(dep.Name)
+	case EcosystemNode:
+		return latestNpm(dep.Name)
+	case EcosystemGo:
+		return latestGoProxy(dep.Name)
+	default:
+		return "", fmt.Errorf("unknown ecosystem %q", dep.Ecosystem)
+	}
+}
+
+func latestPyPI(pkg string) (string, error) {
+	resp, err := http.Get(fmt.Sprintf("https://pypi.org/pypi/%s/json", pkg))
+	if err != nil {
+		return "", fmt.Errorf("failed to query PyPI for %s: %w", pkg, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("PyPI returned %s for %s", resp.Status, pkg)
+	}
+
+	var body struct {
+		Info struct {
+			Version string `json:"version"`
+		} `json:"info"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("failed to parse PyPI response for %s: %w", pkg, err)
+	}
+	return body.Info.Version, nil
+}
+
+func latestNpm(pkg string) (string, error) {
+	resp, err := http.Get(fmt.Sprintf("https://registry.npmjs.org/%s/latest", url.PathEscape(pkg)))
+	if err != nil {
+		return "", fmt.Errorf("failed to query npm for %s: %w", pkg, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("npm registry returned %s for %s", resp.Status, pkg)
+	}
+
+	var body struct {
+		Version string `json:"version"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("failed to parse npm response for %s: %w", pkg, err)
+	}
+	return body.Version, nil
+}
+
+func latestGoProxy(modPath string) (string, error) {
+	resp, err := http.Get(fmt.Sprintf("https://proxy.golang.org/%s/@latest", escapeGoModulePath(modPath)))
+	if err != nil {
+		return "", fmt.Errorf("failed to query Go module proxy for %s: %w", modPath, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Go module proxy returned %s for %s", resp.Status, modPath)
+	}
+
+	var body struct {
+		Version string `json:"Version"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("failed to parse Go module proxy response for %s: %w", modPath, err)
+	}
+	return body.Version, nil
+}
+
+// escapeGoModulePath applies the Go module proxy's case-encoding: every
+// uppercase letter is replaced with "!" followed by its lowercase form.
+func escapeGoModulePath(modPath string) string {
+	var b strings.Builder
+	for _, r := range modPath {
+		if r >= 'A' && r <= 'Z' {
+			b.WriteByte('!')
+			b.WriteRune(r + ('a' - 'A'))
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
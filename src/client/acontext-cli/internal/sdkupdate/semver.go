@@ -0,0 +1,95 @@
+package sdkupdate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Version is a parsed major.minor.patch semantic version. Pre-release and
+// build metadata are ignored for comparison purposes, which is sufficient
+// for deciding whether an Acontext SDK bump is available.
+type Version struct {
+	Major, Minor, Patch int
+}
+
+// ParseVersion parses a version string, tolerating a leading "v" and the
+// constraint operators (^, ~, >=, ==) package manifests commonly prefix
+// dependency versions with.
+func ParseVersion(s string) (Version, error) {
+	s = strings.TrimSpace(s)
+	for _, prefix := range []string{"^", "~", ">=", "==", "="} {
+		s = strings.TrimPrefix(s, prefix)
+	}
+	s = strings.TrimPrefix(s, "v")
+	// A range like ">=1.0,<2.0" only has its lower bound relevant here.
+	if idx := strings.IndexAny(s, ",<> "); idx != -1 {
+		s = s[:idx]
+	}
+
+	parts := strings.SplitN(s, ".", 3)
+	var v Version
+	var err error
+	if v.Major, err = atoiField(parts, 0); err != nil {
+		return Version{}, fmt.Errorf("invalid version %q: %w", s, err)
+	}
+	if v.Minor, err = atoiField(parts, 1); err != nil {
+		v.Minor = 0
+	}
+	if v.Patch, err = atoiField(parts, 2); err != nil {
+		v.Patch = 0
+	}
+	return v, nil
+}
+
+func atoiField(parts []string, i int) (int, error) {
+	if i >= len(parts) {
+		return 0, fmt.Errorf("missing field")
+	}
+	// Strip any trailing pre-release/build suffix, e.g. "3-beta.1".
+	field := parts[i]
+	if idx := strings.IndexAny(field, "-+"); idx != -1 {
+		field = field[:idx]
+	}
+	return strconv.Atoi(field)
+}
+
+// Compare returns -1, 0, or 1 if a is less than, equal to, or greater than b.
+func Compare(a, b Version) int {
+	switch {
+	case a.Major != b.Major:
+		return sign(a.Major - b.Major)
+	case a.Minor != b.Minor:
+		return sign(a.Minor - b.Minor)
+	default:
+		return sign(a.Patch - b.Patch)
+	}
+}
+
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// AllowedBump reports whether upgrading from current to latest is allowed
+// given the --major policy: minor/patch bumps are always allowed, major
+// bumps only when allowMajor is set.
+func AllowedBump(current, latest Version, allowMajor bool) bool {
+	if Compare(latest, current) <= 0 {
+		return false
+	}
+	if latest.Major != current.Major {
+		return allowMajor
+	}
+	return true
+}
@@ -0,0 +1,84 @@
+package sdkupdate
+
+import "testing"
+
+func TestParseVersion(t *testing.T) {
+	cases := []struct {
+		in   string
+		want Version
+	}{
+		{"1.2.3", Version{1, 2, 3}},
+		{"v1.2.3", Version{1, 2, 3}},
+		{"^1.2.3", Version{1, 2, 3}},
+		{"~1.2.3", Version{1, 2, 3}},
+		{">=1.2.3", Version{1, 2, 3}},
+		{"==1.2.3", Version{1, 2, 3}},
+		{"=1.2.3", Version{1, 2, 3}},
+		{">=1.0,<2.0", Version{1, 0, 0}},
+		{"1.2", Version{1, 2, 0}},
+		{"1", Version{1, 0, 0}},
+		{"1.2.3-beta.1", Version{1, 2, 3}},
+		{"  1.2.3  ", Version{1, 2, 3}},
+	}
+
+	for _, c := range cases {
+		got, err := ParseVersion(c.in)
+		if err != nil {
+			t.Errorf("ParseVersion(%q) returned error: %v", c.in, err)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("ParseVersion(%q) = %+v, want %+v", c.in, got, c.want)
+		}
+	}
+}
+
+func TestParseVersionInvalid(t *testing.T) {
+	for _, in := range []string{"", "abc", "v"} {
+		if _, err := ParseVersion(in); err == nil {
+			t.Errorf("ParseVersion(%q) expected error, got nil", in)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	cases := []struct {
+		a, b Version
+		want int
+	}{
+		{Version{1, 0, 0}, Version{1, 0, 0}, 0},
+		{Version{1, 0, 0}, Version{2, 0, 0}, -1},
+		{Version{2, 0, 0}, Version{1, 0, 0}, 1},
+		{Version{1, 1, 0}, Version{1, 2, 0}, -1},
+		{Version{1, 1, 5}, Version{1, 1, 4}, 1},
+	}
+
+	for _, c := range cases {
+		if got := Compare(c.a, c.b); got != c.want {
+			t.Errorf("Compare(%+v, %+v) = %d, want %d", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestAllowedBump(t *testing.T) {
+	cases := []struct {
+		name                 string
+		current, latest      Version
+		allowMajor, expected bool
+	}{
+		{"same version never allowed", Version{1, 2, 3}, Version{1, 2, 3}, false, false},
+		{"downgrade never allowed", Version{1, 2, 3}, Version{1, 2, 0}, true, false},
+		{"patch bump allowed without --major", Version{1, 2, 3}, Version{1, 2, 4}, false, true},
+		{"minor bump allowed without --major", Version{1, 2, 3}, Version{1, 3, 0}, false, true},
+		{"major bump blocked without --major", Version{1, 2, 3}, Version{2, 0, 0}, false, false},
+		{"major bump allowed with --major", Version{1, 2, 3}, Version{2, 0, 0}, true, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := AllowedBump(c.current, c.latest, c.allowMajor); got != c.expected {
+				t.Errorf("AllowedBump(%v, %v, %v) = %v, want %v", c.current, c.latest, c.allowMajor, got, c.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,262 @@
+package template
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/memodb-io/Acontext/acontext-cli/internal/tui"
+	"gopkg.in/yaml.v3"
+)
+
+// ManifestFileName is the name of the optional manifest a template ships at
+// its root to describe additional input variables and post-create hooks.
+const ManifestFileName = "acontext-template.yaml"
+
+// VariableType is the input kind a manifest variable prompts for.
+type VariableType string
+
+const (
+	VariableString      VariableType = "string"
+	VariableBool        VariableType = "bool"
+	VariableSelect      VariableType = "select"
+	VariableMultiSelect VariableType = "multiselect"
+)
+
+// Variable describes one additional input a template wants beyond
+// project_name.
+type Variable struct {
+	Name     string       `yaml:"name"`
+	Type     VariableType `yaml:"type"`
+	Prompt   string       `yaml:"prompt"`
+	Default  string       `yaml:"default"`
+	Options  []string     `yaml:"options"`
+	Validate string       `yaml:"validate"`
+	When     string       `yaml:"when"`
+}
+
+// Hooks is a set of shell commands to run after project creation.
+type Hooks struct {
+	Commands []string `yaml:"commands"`
+}
+
+// Manifest is the parsed form of acontext-template.yaml.
+type Manifest struct {
+	Variables []Variable `yaml:"variables"`
+	Hooks     *Hooks     `yaml:"hooks"`
+}
+
+// LoadManifest reads the template manifest from dir. It returns a nil
+// manifest (without error) if the template doesn't ship one.
+func LoadManifest(dir string) (*Manifest, error) {
+	path := filepath.Join(dir, ManifestFileName)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read %s: %w", ManifestFileName, err)
+	}
+
+	var manifest Manifest
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", ManifestFileName, err)
+	}
+	return &manifest, nil
+}
+
+// whenPattern matches the small `key == "value"` / `key != "value"` subset
+// of expressions manifests are allowed to use in a variable's `when` field.
+var whenPattern = regexp.MustCompile(`^\s*(\w+)\s*(==|!=)\s*"([^"]*)"\s*$`)
+
+// evalWhen reports whether a variable's `when` condition is satisfied by the
+// answers collected so far. An empty condition always passes.
+func evalWhen(when string, vars map[string]string) (bool, error) {
+	if when == "" {
+		return true, nil
+	}
+
+	m := whenPattern.FindStringSubmatch(when)
+	if m == nil {
+		return false, fmt.Errorf("unsupported when expression: %q", when)
+	}
+	key, op, want := m[1], m[2], m[3]
+	got := vars[key]
+
+	if op == "==" {
+		return got == want, nil
+	}
+	return got != want, nil
+}
+
+// PromptVariables walks the manifest's variables in order, skipping any
+// whose name is already present in vars (e.g. supplied via --set or
+// --values), and asks for the rest interactively. Results are merged into
+// vars in place.
+//
+// When no TTY is attached (CI, piped input), no variable is prompted for —
+// doing so would hang on a bubbletea program with nothing to drive it.
+// Instead each variable's declared default is used, and the names of
+// variables without a default are collected and reported in a single error
+// listing every unmet variable, so the caller can fail fast with
+// --set/--values guidance instead of deadlocking.
+func PromptVariables(manifest *Manifest, vars map[string]string) error {
+	if manifest == nil {
+		return nil
+	}
+
+	interactive := tui.IsTTY()
+	var missing []string
+
+	for _, v := range manifest.Variables {
+		if _, ok := vars[v.Name]; ok {
+			continue
+		}
+
+		ok, err := evalWhen(v.When, vars)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		if !interactive {
+			if v.Default != "" {
+				vars[v.Name] = v.Default
+			} else {
+				missing = append(missing, v.Name)
+			}
+			continue
+		}
+
+		answer, err := promptVariable(v)
+		if err != nil {
+			return fmt.Errorf("failed to prompt for %s: %w", v.Name, err)
+		}
+		vars[v.Name] = answer
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("no TTY detected for interactive prompts; supply these template variables with --set or --values: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func promptVariable(v Variable) (string, error) {
+	switch v.Type {
+	case VariableBool:
+		defaultYes := strings.EqualFold(v.Default, "true") || strings.EqualFold(v.Default, "yes")
+		confirmed, err := tui.RunConfirm(v.Prompt, defaultYes)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%t", confirmed), nil
+
+	case VariableSelect:
+		options := make([]tui.SelectOption, len(v.Options))
+		for i, opt := range v.Options {
+			options[i] = tui.SelectOption{Label: opt, Value: opt}
+		}
+		return tui.RunSelect(v.Prompt, options)
+
+	case VariableMultiSelect:
+		var selected []string
+		for _, opt := range v.Options {
+			include, err := tui.RunConfirm(fmt.Sprintf("%s — include %q?", v.Prompt, opt), false)
+			if err != nil {
+				return "", err
+			}
+			if include {
+				selected = append(selected, opt)
+			}
+		}
+		return strings.Join(selected, ","), nil
+
+	default: // VariableString and anything unrecognized
+		answer, err := tui.RunInput(v.Prompt, "", v.Default)
+		if err != nil {
+			return "", err
+		}
+		if answer == "" {
+			answer = v.Default
+		}
+		if v.Validate != "" {
+			re, err := regexp.Compile(v.Validate)
+			if err != nil {
+				return "", fmt.Errorf("invalid validation regex %q: %w", v.Validate, err)
+			}
+			if !re.MatchString(answer) {
+				return "", fmt.Errorf("%q does not match required pattern %q", answer, v.Validate)
+			}
+		}
+		return answer, nil
+	}
+}
+
+// ParseSetFlags turns repeated --set key=value flags into a vars map entry
+// each, merging them into vars.
+func ParseSetFlags(sets []string, vars map[string]string) error {
+	for _, kv := range sets {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return fmt.Errorf("invalid --set value %q, expected key=value", kv)
+		}
+		vars[parts[0]] = parts[1]
+	}
+	return nil
+}
+
+// LoadValuesFile reads a YAML file of variable answers (as produced by
+// --values values.yaml) and merges it into vars.
+func LoadValuesFile(path string, vars map[string]string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read values file: %w", err)
+	}
+
+	var values map[string]string
+	if err := yaml.Unmarshal(data, &values); err != nil {
+		return fmt.Errorf("failed to parse values file: %w", err)
+	}
+	for k, v := range values {
+		vars[k] = v
+	}
+	return nil
+}
+
+// RunHooks executes the manifest's post-create commands inside dir, after
+// confirming with the user.
+func RunHooks(manifest *Manifest, dir string) error {
+	if manifest == nil || manifest.Hooks == nil || len(manifest.Hooks.Commands) == 0 {
+		return nil
+	}
+
+	fmt.Println()
+	fmt.Println("This template wants to run the following commands:")
+	for _, c := range manifest.Hooks.Commands {
+		fmt.Printf("  %s\n", c)
+	}
+
+	proceed, err := tui.RunConfirm("Run these commands now?", true)
+	if err != nil || !proceed {
+		fmt.Printf("%s Skipping post-create commands\n", tui.IconSkip)
+		return nil
+	}
+
+	for _, c := range manifest.Hooks.Commands {
+		fmt.Printf("%s Running: %s\n", tui.IconRocket, c)
+		cmd := exec.Command("sh", "-c", c)
+		cmd.Dir = dir
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		cmd.Stdin = os.Stdin
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("post-create command %q failed: %w", c, err)
+		}
+	}
+	return nil
+}
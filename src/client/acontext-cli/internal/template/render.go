@@ -0,0 +1,60 @@
+package template
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/template"
+)
+
+// templatedExtensions lists the file extensions rendered once the
+// manifest's extra variables have been collected. Binary and lock files are
+// left untouched.
+var templatedExtensions = map[string]bool{
+	".md": true, ".txt": true, ".yaml": true, ".yml": true,
+	".json": true, ".toml": true, ".env": true,
+	".py": true, ".js": true, ".ts": true, ".go": true,
+}
+
+// RenderVars walks dir and executes Go templates against files using the
+// full set of collected vars (project_name plus any manifest-prompted
+// variables, e.g. `{{ .openai_model }}`). This is the only place template
+// placeholders are rendered: text/template renders a key missing from vars
+// as the literal "<no value>", so a file can only be rendered once the
+// complete set of vars is known, not incrementally.
+func RenderVars(dir string, vars map[string]string) error {
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		if !templatedExtensions[filepath.Ext(path)] {
+			return nil
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if !bytes.Contains(data, []byte("{{")) {
+			return nil
+		}
+
+		tmpl, err := template.New(filepath.Base(path)).Parse(string(data))
+		if err != nil {
+			// Not every matching file is actually a Go template; skip ones
+			// that don't parse rather than failing the whole render.
+			return nil
+		}
+
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, vars); err != nil {
+			return fmt.Errorf("failed to render %s: %w", path, err)
+		}
+
+		return os.WriteFile(path, buf.Bytes(), info.Mode())
+	})
+}
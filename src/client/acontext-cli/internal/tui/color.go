@@ -0,0 +1,26 @@
+package tui
+
+import "os"
+
+// ColorEnabled reports whether styled (ANSI color) output should be used.
+// It honors the NO_COLOR convention (https://no-color.org) as well as
+// CI=true, the same CI signal already used elsewhere in this package to
+// fall back to non-interactive behavior.
+func ColorEnabled() bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	if os.Getenv("CI") == "true" {
+		return false
+	}
+	return true
+}
+
+// renderStyled applies style to text when ColorEnabled, and returns text
+// unstyled otherwise.
+func renderStyled(style interface{ Render(...string) string }, text string) string {
+	if !ColorEnabled() {
+		return text
+	}
+	return style.Render(text)
+}
@@ -1,7 +1,10 @@
 package tui
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"os"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -20,14 +23,20 @@ type SpinnerModel struct {
 	result    string
 	quitting  bool
 	startTime time.Time
+	cancel    context.CancelFunc
 }
 
 // NewSpinner creates a new spinner model
 func NewSpinner(message string) SpinnerModel {
+	return newSpinner(message, nil)
+}
+
+func newSpinner(message string, cancel context.CancelFunc) SpinnerModel {
 	return SpinnerModel{
 		message:   message,
 		frame:     0,
 		startTime: time.Now(),
+		cancel:    cancel,
 	}
 }
 
@@ -55,6 +64,9 @@ func (m SpinnerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		switch msg.String() {
 		case "ctrl+c", "q":
 			m.quitting = true
+			if m.cancel != nil {
+				m.cancel()
+			}
 			return m, tea.Quit
 		}
 	case spinnerTickMsg:
@@ -78,34 +90,34 @@ func (m SpinnerModel) View() string {
 	}
 	if m.done {
 		if m.err != nil {
-			return ErrorStyle.Render(IconError+" ") + m.message + " " + ErrorStyle.Render("failed")
+			return renderStyled(ErrorStyle, IconError+" ") + m.message + " " + renderStyled(ErrorStyle, "failed")
 		}
 		if m.result != "" {
-			return SuccessStyle.Render(IconSuccess+" ") + m.result
+			return renderStyled(SuccessStyle, IconSuccess+" ") + m.result
 		}
-		return SuccessStyle.Render(IconSuccess+" ") + m.message + " " + SuccessStyle.Render("done")
+		return renderStyled(SuccessStyle, IconSuccess+" ") + m.message + " " + renderStyled(SuccessStyle, "done")
 	}
 
-	spinner := lipgloss.NewStyle().Foreground(ColorPrimary).Render(spinnerFrames[m.frame])
+	spinner := spinnerFrames[m.frame]
+	if ColorEnabled() {
+		spinner = lipgloss.NewStyle().Foreground(ColorPrimary).Render(spinner)
+	}
 	return spinner + " " + m.message
 }
 
-// RunSpinner runs a spinner while executing a function
-// Returns the result string and error from the function
-func RunSpinner(message string, fn func() (string, error)) (string, error) {
+// RunSpinnerCtx runs a spinner while executing fn, passing it a context that
+// is cancelled the moment the user hits Ctrl+C (or q) on the spinner, so fn
+// can abort in-flight work such as an HTTP request instead of leaking a
+// goroutine after the bubbletea program has already quit.
+func RunSpinnerCtx(ctx context.Context, message string, fn func(context.Context) (string, error)) (string, error) {
 	if !IsTTY() {
-		// Fallback for non-TTY: just print message and run function
-		fmt.Print(message + "... ")
-		result, err := fn()
-		if err != nil {
-			fmt.Println(ErrorStyle.Render("failed"))
-		} else {
-			fmt.Println(SuccessStyle.Render("done"))
-		}
-		return result, err
+		return runSpinnerNonTTY(ctx, message, fn)
 	}
 
-	m := NewSpinner(message)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	m := newSpinner(message, cancel)
 	p := tea.NewProgram(m)
 
 	// Run the function in a goroutine
@@ -115,7 +127,7 @@ func RunSpinner(message string, fn func() (string, error)) (string, error) {
 	}, 1)
 
 	go func() {
-		result, err := fn()
+		result, err := fn(ctx)
 		resultChan <- struct {
 			result string
 			err    error
@@ -133,6 +145,14 @@ func RunSpinner(message string, fn func() (string, error)) (string, error) {
 	return res.result, res.err
 }
 
+// RunSpinner is a thin wrapper around RunSpinnerCtx for callers that don't
+// need cancellation and just want to run fn to completion.
+func RunSpinner(message string, fn func() (string, error)) (string, error) {
+	return RunSpinnerCtx(context.Background(), message, func(ctx context.Context) (string, error) {
+		return fn()
+	})
+}
+
 // RunSpinnerSimple runs a spinner for a function that only returns an error
 func RunSpinnerSimple(message string, fn func() error) error {
 	_, err := RunSpinner(message, func() (string, error) {
@@ -140,3 +160,53 @@ func RunSpinnerSimple(message string, fn func() error) error {
 	})
 	return err
 }
+
+// spinnerEvent is one line of the machine-parseable non-TTY spinner log
+// emitted when ACONTEXT_LOG_FORMAT=json is set.
+type spinnerEvent struct {
+	Event      string `json:"event"`
+	Message    string `json:"message,omitempty"`
+	Result     string `json:"result,omitempty"`
+	Error      string `json:"error,omitempty"`
+	DurationMs int64  `json:"duration_ms,omitempty"`
+}
+
+// runSpinnerNonTTY executes fn without a bubbletea program, for environments
+// with no terminal (CI, pipes). When ACONTEXT_LOG_FORMAT=json is set it
+// emits one JSON line per phase instead of the plain-text message, so CI
+// logs remain machine-parseable.
+func runSpinnerNonTTY(ctx context.Context, message string, fn func(context.Context) (string, error)) (string, error) {
+	jsonLog := os.Getenv("ACONTEXT_LOG_FORMAT") == "json"
+	start := time.Now()
+
+	if jsonLog {
+		emitSpinnerEvent(spinnerEvent{Event: "spinner.start", Message: message})
+	} else {
+		fmt.Print(message + "... ")
+	}
+
+	result, err := fn(ctx)
+	duration := time.Since(start).Milliseconds()
+
+	if jsonLog {
+		event := spinnerEvent{Event: "spinner.done", Result: result, DurationMs: duration}
+		if err != nil {
+			event.Error = err.Error()
+		}
+		emitSpinnerEvent(event)
+	} else if err != nil {
+		fmt.Println(renderStyled(ErrorStyle, "failed"))
+	} else {
+		fmt.Println(renderStyled(SuccessStyle, "done"))
+	}
+
+	return result, err
+}
+
+func emitSpinnerEvent(event spinnerEvent) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return
+	}
+	fmt.Println(string(data))
+}
@@ -0,0 +1,116 @@
+package version
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// UpdateCache is the on-disk record of the last background update check.
+type UpdateCache struct {
+	LatestVersion string    `json:"latest_version"`
+	CheckedAt     time.Time `json:"checked_at"`
+}
+
+// checkTimeout bounds how long a single background update check is allowed
+// to block before its result is discarded for this invocation.
+const checkTimeout = 500 * time.Millisecond
+
+// cachePath returns the path to the update cache file, creating its parent
+// directory if necessary.
+func cachePath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	dir := filepath.Join(home, ".config", "acontext")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "update.json"), nil
+}
+
+// LoadCache reads the cached update-check result. It returns a nil cache
+// (without error) if no check has ever completed successfully.
+func LoadCache() (*UpdateCache, error) {
+	path, err := cachePath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var cache UpdateCache
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return nil, err
+	}
+	return &cache, nil
+}
+
+// SaveCache writes the update-check result to disk.
+func SaveCache(cache *UpdateCache) error {
+	path, err := cachePath()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(cache, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// CacheExpired reports whether the cached result is older than ttl, and
+// therefore due for a refresh.
+func CacheExpired(cache *UpdateCache, ttl time.Duration) bool {
+	if cache == nil {
+		return true
+	}
+	return time.Since(cache.CheckedAt) > ttl
+}
+
+// RunBackgroundCheck checks for a new version and refreshes the on-disk
+// cache, blocking the caller for at most checkTimeout. It must block rather
+// than merely detach a goroutine: cobra's Execute() returns, and the process
+// exits, right after PersistentPostRunE returns, so nothing keeps a
+// fire-and-forget goroutine alive long enough to finish its HTTP call and
+// write the cache. checkTimeout keeps that delay small enough not to be
+// noticeable on an otherwise-fast command.
+func RunBackgroundCheck(currentVersion string) {
+	ctx, cancel := context.WithTimeout(context.Background(), checkTimeout)
+	defer cancel()
+
+	type result struct {
+		latest string
+		err    error
+	}
+	resultCh := make(chan result, 1)
+
+	go func() {
+		_, latest, err := IsUpdateAvailable(currentVersion)
+		resultCh <- result{latest: latest, err: err}
+	}()
+
+	select {
+	case res := <-resultCh:
+		if res.err != nil {
+			return
+		}
+		_ = SaveCache(&UpdateCache{
+			LatestVersion: res.latest,
+			CheckedAt:     time.Now(),
+		})
+	case <-ctx.Done():
+		// The check didn't finish in time; leave the existing cache
+		// untouched and try again on the next invocation.
+	}
+}
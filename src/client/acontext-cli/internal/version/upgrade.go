@@ -0,0 +1,469 @@
+package version
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// githubReleasesAPI is queried for the latest published release.
+const githubReleasesAPI = "https://api.github.com/repos/memodb-io/Acontext/releases/latest"
+
+// pinnedPublicKey is an optional ed25519 public key (raw, hex-encoded)
+// baked in at build time via -ldflags to verify checksums.txt.sig. When
+// empty, signature verification is skipped and only the SHA256 checksum is
+// checked.
+var pinnedPublicKey string
+
+// GithubAsset is one downloadable file attached to a release.
+type GithubAsset struct {
+	Name               string `json:"name"`
+	BrowserDownloadURL string `json:"browser_download_url"`
+}
+
+// GithubRelease is the subset of the GitHub releases API response we need.
+type GithubRelease struct {
+	TagName string        `json:"tag_name"`
+	Assets  []GithubAsset `json:"assets"`
+}
+
+// LatestRelease fetches the latest published release from GitHub. ctx
+// bounds the request so it can be aborted mid-flight (e.g. Ctrl+C).
+func LatestRelease(ctx context.Context) (*GithubRelease, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, githubReleasesAPI, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build GitHub releases API request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach GitHub releases API: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GitHub releases API returned %s", resp.Status)
+	}
+
+	var release GithubRelease
+	if err := json.NewDecoder(resp.Body).Decode(&release); err != nil {
+		return nil, fmt.Errorf("failed to parse GitHub release: %w", err)
+	}
+	return &release, nil
+}
+
+// PerformUpgrade fetches the latest release, downloads and verifies the
+// platform-matching archive into tmpDir, and atomically replaces the
+// running binary with its contents. It returns the release that was
+// installed. ctx is checked between each network/IO step so a cancelled
+// context (e.g. the user hit Ctrl+C on the spinner) stops the upgrade
+// before the next step starts.
+func PerformUpgrade(ctx context.Context, tmpDir string) (*GithubRelease, error) {
+	release, err := LatestRelease(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	assetName := AssetName(release.TagName)
+
+	archivePath, checksumsPath, err := DownloadRelease(ctx, release, assetName, tmpDir)
+	if err != nil {
+		return nil, err
+	}
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	if err := VerifyChecksum(archivePath, checksumsPath, assetName); err != nil {
+		return nil, err
+	}
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	binaryPath, err := ExtractBinary(archivePath, tmpDir)
+	if err != nil {
+		return nil, err
+	}
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	if err := ReplaceRunningBinary(binaryPath); err != nil {
+		return nil, err
+	}
+
+	return release, nil
+}
+
+// archiveExt is the archive format used for the current platform's release
+// asset: zip on Windows, tar.gz everywhere else.
+func archiveExt() string {
+	if runtime.GOOS == "windows" {
+		return "zip"
+	}
+	return "tar.gz"
+}
+
+// AssetName returns the expected release asset filename for the running
+// GOOS/GOARCH, e.g. "acontext_0.3.0_linux_amd64.tar.gz".
+func AssetName(tag string) string {
+	version := strings.TrimPrefix(tag, "v")
+	return fmt.Sprintf("acontext_%s_%s_%s.%s", version, runtime.GOOS, runtime.GOARCH, archiveExt())
+}
+
+// FindAsset returns the release asset matching name, if any.
+func FindAsset(release *GithubRelease, name string) (*GithubAsset, bool) {
+	for i, asset := range release.Assets {
+		if asset.Name == name {
+			return &release.Assets[i], true
+		}
+	}
+	return nil, false
+}
+
+// downloadFile streams url to destPath. ctx bounds the request so it can
+// be aborted mid-flight (e.g. Ctrl+C).
+func downloadFile(ctx context.Context, url, destPath string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("download of %s returned %s", url, resp.Status)
+	}
+
+	out, err := os.Create(destPath)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, resp.Body)
+	return err
+}
+
+// DownloadRelease downloads the archive and its checksums.txt (and, if
+// present, checksums.txt.sig) for assetName into dir.
+func DownloadRelease(ctx context.Context, release *GithubRelease, assetName, dir string) (archivePath, checksumsPath string, err error) {
+	asset, ok := FindAsset(release, assetName)
+	if !ok {
+		return "", "", fmt.Errorf("no release asset found for this platform (%s)", assetName)
+	}
+
+	archivePath = filepath.Join(dir, assetName)
+	if err := downloadFile(ctx, asset.BrowserDownloadURL, archivePath); err != nil {
+		return "", "", fmt.Errorf("failed to download %s: %w", assetName, err)
+	}
+
+	checksumsAsset, ok := FindAsset(release, "checksums.txt")
+	if !ok {
+		return "", "", fmt.Errorf("release is missing checksums.txt")
+	}
+	checksumsPath = filepath.Join(dir, "checksums.txt")
+	if err := downloadFile(ctx, checksumsAsset.BrowserDownloadURL, checksumsPath); err != nil {
+		return "", "", fmt.Errorf("failed to download checksums.txt: %w", err)
+	}
+
+	if sigAsset, ok := FindAsset(release, "checksums.txt.sig"); ok {
+		sigPath := filepath.Join(dir, "checksums.txt.sig")
+		if err := downloadFile(ctx, sigAsset.BrowserDownloadURL, sigPath); err != nil {
+			return "", "", fmt.Errorf("failed to download checksums.txt.sig: %w", err)
+		}
+	}
+
+	return archivePath, checksumsPath, nil
+}
+
+// VerifyChecksum confirms archivePath's SHA256 matches the entry for
+// assetName in checksums.txt, and, when a public key is pinned in this
+// binary, that checksums.txt itself is signed by checksums.txt.sig.
+func VerifyChecksum(archivePath, checksumsPath, assetName string) error {
+	checksums, err := os.ReadFile(checksumsPath)
+	if err != nil {
+		return fmt.Errorf("failed to read checksums.txt: %w", err)
+	}
+
+	if pinnedPublicKey != "" {
+		sigPath := checksumsPath + ".sig"
+		if err := verifySignature(checksums, sigPath); err != nil {
+			return fmt.Errorf("checksums.txt signature verification failed: %w", err)
+		}
+	}
+
+	var want string
+	for _, line := range strings.Split(string(checksums), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 && fields[1] == assetName {
+			want = fields[0]
+			break
+		}
+	}
+	if want == "" {
+		return fmt.Errorf("no checksum entry for %s", assetName)
+	}
+
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return err
+	}
+	got := hex.EncodeToString(h.Sum(nil))
+
+	if got != want {
+		return fmt.Errorf("checksum mismatch for %s: expected %s, got %s", assetName, want, got)
+	}
+	return nil
+}
+
+// verifySignature checks a detached ed25519 signature (minisign/cosign-style
+// raw signature) over data using the pinned public key.
+func verifySignature(data []byte, sigPath string) error {
+	keyBytes, err := hex.DecodeString(pinnedPublicKey)
+	if err != nil || len(keyBytes) != ed25519.PublicKeySize {
+		return fmt.Errorf("invalid pinned public key")
+	}
+
+	sig, err := os.ReadFile(sigPath)
+	if err != nil {
+		return fmt.Errorf("failed to read signature: %w", err)
+	}
+
+	if !ed25519.Verify(ed25519.PublicKey(keyBytes), data, sig) {
+		return fmt.Errorf("signature does not match pinned public key")
+	}
+	return nil
+}
+
+// ExtractBinary unpacks the "acontext" (or "acontext.exe") binary from
+// archivePath into dir and returns its path.
+func ExtractBinary(archivePath, dir string) (string, error) {
+	binaryName := "acontext"
+	if runtime.GOOS == "windows" {
+		binaryName = "acontext.exe"
+	}
+
+	if strings.HasSuffix(archivePath, ".zip") {
+		return extractFromZip(archivePath, dir, binaryName)
+	}
+	return extractFromTarGz(archivePath, dir, binaryName)
+}
+
+func extractFromTarGz(archivePath, dir, binaryName string) (string, error) {
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return "", err
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		if filepath.Base(hdr.Name) != binaryName {
+			continue
+		}
+
+		outPath := filepath.Join(dir, binaryName)
+		out, err := os.OpenFile(outPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0755)
+		if err != nil {
+			return "", err
+		}
+		defer out.Close()
+		if _, err := io.Copy(out, tr); err != nil {
+			return "", err
+		}
+		return outPath, nil
+	}
+	return "", fmt.Errorf("%s not found in archive", binaryName)
+}
+
+func extractFromZip(archivePath, dir, binaryName string) (string, error) {
+	r, err := zip.OpenReader(archivePath)
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+
+	for _, f := range r.File {
+		if filepath.Base(f.Name) != binaryName {
+			continue
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return "", err
+		}
+		defer rc.Close()
+
+		outPath := filepath.Join(dir, binaryName)
+		out, err := os.OpenFile(outPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0755)
+		if err != nil {
+			return "", err
+		}
+		defer out.Close()
+		if _, err := io.Copy(out, rc); err != nil {
+			return "", err
+		}
+		return outPath, nil
+	}
+	return "", fmt.Errorf("%s not found in archive", binaryName)
+}
+
+// resolveExecPath returns the real (symlink-resolved) path of the running
+// binary.
+func resolveExecPath() (string, error) {
+	execPath, err := os.Executable()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate running binary: %w", err)
+	}
+	execPath, err = filepath.EvalSymlinks(execPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve running binary path: %w", err)
+	}
+	return execPath, nil
+}
+
+// NewStagingDir creates a temporary directory for an upgrade's downloaded
+// archive and extracted binary. It's created next to the running binary
+// rather than under the OS default temp dir (usually /tmp) so the final
+// os.Rename in ReplaceRunningBinary lands on the same filesystem — renaming
+// across filesystems (e.g. a tmpfs /tmp into /usr/local/bin) fails with
+// "invalid cross-device link". The caller is responsible for removing it.
+func NewStagingDir() (string, error) {
+	execPath, err := resolveExecPath()
+	if err != nil {
+		return "", err
+	}
+	dir, err := os.MkdirTemp(filepath.Dir(execPath), "acontext-upgrade-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to create staging directory: %w", err)
+	}
+	return dir, nil
+}
+
+// ReplaceRunningBinary atomically swaps the running executable for
+// newBinaryPath, keeping the previous binary alongside it (suffixed
+// ".old") so that RollbackUpgrade can restore it later.
+func ReplaceRunningBinary(newBinaryPath string) error {
+	execPath, err := resolveExecPath()
+	if err != nil {
+		return err
+	}
+
+	backupPath := execPath + ".old"
+
+	if runtime.GOOS == "windows" {
+		// Windows refuses to overwrite a running executable directly, so
+		// the live binary must be moved aside first.
+		_ = os.Remove(backupPath)
+		if err := os.Rename(execPath, backupPath); err != nil {
+			return fmt.Errorf("failed to back up running binary: %w", err)
+		}
+		if err := os.Rename(newBinaryPath, execPath); err != nil {
+			_ = os.Rename(backupPath, execPath) // best-effort restore
+			return fmt.Errorf("failed to install new binary: %w", err)
+		}
+		return nil
+	}
+
+	// On Unix, os.Rename is atomic and safe even while execPath is running,
+	// but we still keep a backup to support --rollback.
+	if err := copyFile(execPath, backupPath); err != nil {
+		return fmt.Errorf("failed to back up running binary: %w", err)
+	}
+	if err := os.Chmod(newBinaryPath, 0755); err != nil {
+		return err
+	}
+	if err := os.Rename(newBinaryPath, execPath); err != nil {
+		return fmt.Errorf("failed to install new binary: %w", err)
+	}
+	return nil
+}
+
+// RollbackUpgrade restores the ".old" binary saved by the previous upgrade.
+func RollbackUpgrade() error {
+	execPath, err := resolveExecPath()
+	if err != nil {
+		return err
+	}
+
+	backupPath := execPath + ".old"
+	if _, err := os.Stat(backupPath); err != nil {
+		return fmt.Errorf("no previous version to roll back to: %w", err)
+	}
+
+	if runtime.GOOS == "windows" {
+		// Same constraint as ReplaceRunningBinary: Windows refuses to
+		// overwrite a running executable directly, so the live binary must
+		// be moved aside before the backup can take its place.
+		rejectedPath := execPath + ".rejected"
+		_ = os.Remove(rejectedPath)
+		if err := os.Rename(execPath, rejectedPath); err != nil {
+			return fmt.Errorf("failed to move aside running binary: %w", err)
+		}
+		if err := os.Rename(backupPath, execPath); err != nil {
+			_ = os.Rename(rejectedPath, execPath) // best-effort restore
+			return fmt.Errorf("failed to restore previous binary: %w", err)
+		}
+		_ = os.Remove(rejectedPath)
+		return nil
+	}
+
+	return os.Rename(backupPath, execPath)
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0755)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, in)
+	return err
+}